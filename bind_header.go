@@ -0,0 +1,139 @@
+package lessgo
+
+import (
+	"net/http"
+	"net/textproto"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// BindHeader populates the fields of i from the request's headers, using
+// `header:"X-Rate"` struct tags. Header keys are canonicalized with
+// textproto.CanonicalMIMEHeaderKey before lookup. Supported field types are
+// string, the int/uint widths, float32/float64, bool, time.Time (formatted
+// per an optional `time_format` tag) and slices thereof for multi-value
+// headers.
+func (c *context) BindHeader(i interface{}) error {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return NewHTTPError(http.StatusBadRequest, "BindHeader: destination must be a non-nil pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("header")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		key := textproto.CanonicalMIMEHeaderKey(tag)
+		values := c.Request().Header().Values(key)
+		if len(values) == 0 {
+			continue
+		}
+
+		if err := bindHeaderField(v.Field(i), field, values); err != nil {
+			return NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+	return nil
+}
+
+func bindHeaderField(fv reflect.Value, field reflect.StructField, values []string) error {
+	if fv.Kind() == reflect.Slice {
+		elemType := fv.Type().Elem()
+		slice := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i, raw := range values {
+			if err := setHeaderValue(slice.Index(i), elemType, field, raw); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+	return setHeaderValue(fv, fv.Type(), field, values[0])
+}
+
+func setHeaderValue(fv reflect.Value, typ reflect.Type, field reflect.StructField, raw string) error {
+	if typ == reflect.TypeOf(time.Time{}) {
+		layout := field.Tag.Get("time_format")
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		tm, err := time.Parse(layout, raw)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(tm))
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		fv.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, typ.Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, typ.Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, typ.Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	default:
+		return NewHTTPError(http.StatusBadRequest, "BindHeader: unsupported field type "+typ.String())
+	}
+	return nil
+}
+
+// hasTaggedFields reports whether i's struct type has at least one field
+// tagged with the given tag family, so Bind can tell a `header:"..."`
+// struct apart from a plain one when the request carries no Content-Type.
+func hasTaggedFields(i interface{}, tag string) bool {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if v := t.Field(i).Tag.Get(tag); v != "" && v != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// BindWith binds the request using an explicit tag family rather than the
+// one inferred from the request's content type. Bind falls back to
+// BindWith(i, "header") when the request carries no Content-Type but i has
+// `header:"..."` tagged fields.
+func (c *context) BindWith(i interface{}, tag string) error {
+	switch tag {
+	case "header":
+		return c.BindHeader(i)
+	default:
+		return NewHTTPError(http.StatusBadRequest, "BindWith: unsupported tag "+tag)
+	}
+}
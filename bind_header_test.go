@@ -0,0 +1,44 @@
+package lessgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+type rateLimitHeader struct {
+	Rate     int       `header:"X-Rate"`
+	Tags     []string  `header:"X-Tag"`
+	IssuedAt time.Time `header:"X-Issued-At" time_format:"2006-01-02"`
+}
+
+func TestContextBindHeader(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rq.Header().Add("X-Rate", "120")
+	rq.Header().Add("X-Tag", "a")
+	rq.Header().Add("X-Tag", "b")
+	rq.Header().Add("X-Issued-At", "2016-01-02")
+
+	c := NewContext(rq, nil, New())
+	h := new(rateLimitHeader)
+	if assert.NoError(t, c.BindHeader(h)) {
+		assert.Equal(t, 120, h.Rate)
+		assert.Equal(t, []string{"a", "b"}, h.Tags)
+		assert.Equal(t, 2016, h.IssuedAt.Year())
+	}
+}
+
+func TestContextBindHeaderError(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rq.Header().Add("X-Rate", "not-a-number")
+
+	c := NewContext(rq, nil, New())
+	h := new(rateLimitHeader)
+	err := c.BindHeader(h)
+	if assert.IsType(t, new(HTTPError), err) {
+		assert.Equal(t, 400, err.(*HTTPError).Code)
+	}
+}
@@ -0,0 +1,45 @@
+package lessgo
+
+import (
+	"mime/multipart"
+	"net/http"
+	"reflect"
+)
+
+// bindMultipartFormFiles populates fields of i tagged `form:"name"` of type
+// *multipart.FileHeader or []*multipart.FileHeader from form, leaving all
+// other fields untouched. Bind calls this after its regular form-value pass
+// when the request is multipart/form-data, so a single struct can mix
+// scalar fields and uploaded files.
+func bindMultipartFormFiles(form *multipart.Form, i interface{}) error {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return NewHTTPError(http.StatusBadRequest, "Bind: destination must be a non-nil pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	fileHeaderType := reflect.TypeOf((*multipart.FileHeader)(nil))
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("form")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		files := form.File[name]
+		if len(files) == 0 {
+			continue
+		}
+
+		fv := v.Field(i)
+		switch {
+		case fv.Type() == fileHeaderType:
+			fv.Set(reflect.ValueOf(files[0]))
+		case fv.Type() == reflect.SliceOf(fileHeaderType):
+			fv.Set(reflect.ValueOf(files))
+		}
+	}
+	return nil
+}
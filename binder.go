@@ -0,0 +1,58 @@
+package lessgo
+
+import "io"
+
+type (
+	// BodyBinder decodes a request body into i. It is the extension point
+	// used by Bind to support content types beyond the built-in JSON/XML/form
+	// handling, so callers can register codecs (protobuf, msgpack, ...)
+	// without patching core.
+	BodyBinder interface {
+		Bind(r io.Reader, i interface{}) error
+	}
+
+	// BodyRenderer encodes v and writes the result to w. It is the response
+	// counterpart of BodyBinder.
+	BodyRenderer interface {
+		Render(w io.Writer, v interface{}) error
+	}
+)
+
+var (
+	binders   = map[string]BodyBinder{}
+	renderers = map[string]BodyRenderer{}
+)
+
+// RegisterBinder registers a BodyBinder for the given MIME type. Bind uses
+// the registry when it encounters a content type it does not natively
+// understand.
+func RegisterBinder(mime string, b BodyBinder) {
+	binders[mime] = b
+}
+
+// RegisterRenderer registers a BodyRenderer for the given MIME type.
+func RegisterRenderer(mime string, r BodyRenderer) {
+	renderers[mime] = r
+}
+
+// bindRegistered looks up a BodyBinder for ct and, if one is registered,
+// uses it to decode r into i. The bool return reports whether a codec was
+// found, so callers can fall back to ErrUnsupportedMediaType only when one
+// was not.
+func bindRegistered(ct string, r io.Reader, i interface{}) (bool, error) {
+	b, ok := binders[ct]
+	if !ok {
+		return false, nil
+	}
+	return true, b.Bind(r, i)
+}
+
+// renderRegistered looks up a BodyRenderer for ct and, if one is registered,
+// uses it to encode i to w.
+func renderRegistered(ct string, w io.Writer, i interface{}) (bool, error) {
+	r, ok := renderers[ct]
+	if !ok {
+		return false, nil
+	}
+	return true, r.Render(w, i)
+}
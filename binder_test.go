@@ -0,0 +1,62 @@
+package lessgo
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+type fakeCodec struct{}
+
+func (fakeCodec) Bind(r io.Reader, i interface{}) error {
+	u := i.(*user)
+	u.ID = "9"
+	u.Name = "Registered"
+	return nil
+}
+
+func (fakeCodec) Render(w io.Writer, i interface{}) error {
+	_, err := w.Write([]byte("fake"))
+	return err
+}
+
+const applicationFake = "application/x-fake"
+
+func TestBinderRegistry(t *testing.T) {
+	RegisterBinder(applicationFake, fakeCodec{})
+	RegisterRenderer(applicationFake, fakeCodec{})
+
+	ok, err := bindRegistered(applicationFake, strings.NewReader(""), new(user))
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	ok, err = renderRegistered(applicationFake, &buf, user{})
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", buf.String())
+
+	ok, err = bindRegistered("application/does-not-exist", strings.NewReader(""), new(user))
+	assert.False(t, ok)
+	assert.NoError(t, err)
+}
+
+// TestContextBindRegistered exercises the registry end-to-end through
+// Bind, not just via the internal bindRegistered helper, so a codec
+// registered for a custom MIME type actually takes effect on c.Bind.
+func TestContextBindRegistered(t *testing.T) {
+	rq := test.NewRequest(POST, "/", strings.NewReader(""))
+	rq.Header().Set(ContentType, applicationFake)
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	u := new(user)
+	if assert.NoError(t, c.Bind(u)) {
+		assert.Equal(t, "9", u.ID)
+		assert.Equal(t, "Registered", u.Name)
+	}
+}
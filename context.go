@@ -0,0 +1,487 @@
+package lessgo
+
+import (
+	"bytes"
+	xcontext "context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+type (
+	// Context represents the context of the current HTTP request. It holds
+	// request and response objects, path, path parameters, data and
+	// registered handler.
+	Context interface {
+		// Request returns the underlying *Request.
+		Request() *Request
+
+		// Response returns the underlying *Response.
+		Response() *Response
+
+		// Object returns the underlying *context, mainly for use in tests.
+		Object() *context
+
+		// Path returns the registered path for the handler.
+		Path() string
+
+		// P returns path parameter by index.
+		P(i int) string
+
+		// Param returns path parameter by name.
+		Param(name string) string
+
+		// ParamNames returns path parameter names.
+		ParamNames() []string
+
+		// QueryParam returns the query param for the provided name.
+		QueryParam(name string) string
+
+		// FormValue returns the form field value for the provided name.
+		FormValue(name string) string
+
+		// Set stores a value under key in the Context's store.
+		Set(key string, val interface{})
+
+		// Get retrieves a value under key from the Context's store.
+		Get(key string) interface{}
+
+		// Bind decodes the request body into i, dispatching on Content-Type.
+		Bind(i interface{}) error
+
+		// BindHeader populates i from the request headers using
+		// `header:"..."` tags; see bind_header.go.
+		BindHeader(i interface{}) error
+
+		// BindWith binds the request using an explicit tag family instead
+		// of the one inferred from Content-Type; see bind_header.go.
+		BindWith(i interface{}, tag string) error
+
+		// Render renders a template with data and sends a text/html response
+		// with status code.
+		Render(code int, name string, data interface{}) error
+
+		// JSON sends a JSON response with status code.
+		JSON(code int, i interface{}) error
+
+		// JSONP sends a JSONP response with status code.
+		JSONP(code int, callback string, i interface{}) error
+
+		// XML sends an XML response with status code.
+		XML(code int, i interface{}) error
+
+		// String sends a text/plain response with status code.
+		String(code int, s string) error
+
+		// HTML sends an HTML response with status code.
+		HTML(code int, html string) error
+
+		// Blob sends a blob response with status code and content type.
+		Blob(code int, contentType string, b []byte) error
+
+		// Attachment sends a response as an attachment, prompting the client
+		// to save the file.
+		Attachment(r io.Reader, name string) error
+
+		// NoContent sends a response with no body and a status code.
+		NoContent(code int) error
+
+		// Redirect redirects the request to the provided URL with status
+		// code.
+		Redirect(code int, url string) error
+
+		// Error invokes the registered HTTP error handler.
+		Error(err error)
+
+		// ServeContent serves content, setting appropriate caching headers.
+		ServeContent(content io.ReadSeeker, name string, modtime time.Time) error
+
+		// Protobuf sends a protobuf response with status code; see
+		// protobuf.go.
+		Protobuf(code int, v interface{}) error
+
+		// ProtobufStream writes a sequence of protobuf messages to the
+		// response without buffering the whole stream; see protobuf.go.
+		ProtobufStream(code int, next func() (msg proto.Message, ok bool)) error
+
+		// MsgPack sends a MessagePack response with status code; see
+		// msgpack.go.
+		MsgPack(code int, v interface{}) error
+
+		// SSEvent writes a single named Server-Sent Event frame; see sse.go.
+		SSEvent(name string, data interface{}) error
+
+		// SSEventWithOptions is like SSEvent but also emits `id:`/`retry:`
+		// lines; see sse.go.
+		SSEventWithOptions(name string, data interface{}, opts *SSEOptions) error
+
+		// Stream repeatedly calls step, flushing after each call, until it
+		// returns false or the request is canceled; see sse.go.
+		Stream(step func(w io.Writer) bool) error
+
+		// Negotiate dispatches to the renderer matching the best offer for
+		// the request's Accept header; see negotiate.go.
+		Negotiate(code int, offered NegotiateConfig) error
+
+		// NegotiateFormat returns the best match among offered for the
+		// request's Accept header, or "" if none is acceptable; see
+		// negotiate.go.
+		NegotiateFormat(offered ...string) string
+
+		// MultipartForm parses and returns the request's multipart form;
+		// see multipart.go.
+		MultipartForm() (*multipart.Form, error)
+
+		// FormFile returns the first uploaded file for the given form key;
+		// see multipart.go.
+		FormFile(name string) (*multipart.FileHeader, error)
+
+		// SaveUploadedFile writes fh to dst; see multipart.go.
+		SaveUploadedFile(fh *multipart.FileHeader, dst string) error
+
+		// Deadline, Done, Err and Value make Context satisfy the standard
+		// context.Context interface; see context_value.go.
+		Deadline() (deadline time.Time, ok bool)
+		Done() <-chan struct{}
+		Err() error
+		Value(key interface{}) interface{}
+
+		// WithValue, WithCancel, WithTimeout and WithDeadline return a
+		// derived Context backed by a new context.Context; see
+		// context_value.go.
+		WithValue(key, val interface{}) Context
+		WithCancel() (Context, xcontext.CancelFunc)
+		WithTimeout(d time.Duration) (Context, xcontext.CancelFunc)
+		WithDeadline(t time.Time) (Context, xcontext.CancelFunc)
+	}
+
+	// context is the default Context implementation.
+	context struct {
+		request  *Request
+		response *Response
+		path     string
+		pnames   []string
+		pvalues  []string
+		store    map[string]interface{}
+		echo     *Echo
+
+		// ctx, when set (via WithValue/WithCancel/WithTimeout/WithDeadline
+		// in context_value.go), backs Deadline/Done/Err/Value instead of
+		// the incoming request's context.Context.
+		ctx xcontext.Context
+	}
+
+	user struct {
+		ID   string `json:"id" xml:"id" form:"id" query:"id"`
+		Name string `json:"name" xml:"name" form:"name" query:"name"`
+	}
+)
+
+// NewContext creates a Context for the given request/response pair. r is
+// typically built via test.NewRequest in tests or NewRequest(req) when
+// serving a real *http.Request.
+func NewContext(r *Request, w http.ResponseWriter, e *Echo) Context {
+	var res *Response
+	if w != nil {
+		res = NewResponse(w)
+	}
+	return &context{
+		request:  r,
+		response: res,
+		store:    make(map[string]interface{}),
+		echo:     e,
+	}
+}
+
+func (c *context) Request() *Request {
+	return c.request
+}
+
+func (c *context) Response() *Response {
+	return c.response
+}
+
+func (c *context) Object() *context {
+	return c
+}
+
+func (c *context) Path() string {
+	return c.path
+}
+
+func (c *context) P(i int) string {
+	if i < len(c.pvalues) {
+		return c.pvalues[i]
+	}
+	return ""
+}
+
+func (c *context) Param(name string) string {
+	for i, n := range c.pnames {
+		if n == name && i < len(c.pvalues) {
+			return c.pvalues[i]
+		}
+	}
+	return ""
+}
+
+func (c *context) ParamNames() []string {
+	return c.pnames
+}
+
+func (c *context) QueryParam(name string) string {
+	return c.request.QueryParam(name)
+}
+
+func (c *context) FormValue(name string) string {
+	return c.request.FormValue(name)
+}
+
+func (c *context) Set(key string, val interface{}) {
+	c.store[key] = val
+}
+
+func (c *context) Get(key string) interface{} {
+	if v, ok := c.store[key]; ok {
+		return v
+	}
+	return c.Value(key)
+}
+
+// Bind decodes the request body into i. It dispatches on the request's
+// Content-Type: application/json, application/xml, application/x-www-form-
+// urlencoded and multipart/form-data are handled natively; any type
+// registered via RegisterBinder (see binder.go) is tried next; when the
+// request carries no Content-Type at all but i has `header:"..."` tagged
+// fields, Bind falls back to BindWith(i, "header") (see bind_header.go).
+// Anything else is ErrUnsupportedMediaType.
+func (c *context) Bind(i interface{}) error {
+	ct := c.request.Header().Get(ContentType)
+
+	switch {
+	case ct == "":
+		if hasTaggedFields(i, "header") {
+			return c.BindWith(i, "header")
+		}
+		return ErrUnsupportedMediaType
+
+	case strings.HasPrefix(ct, ApplicationJSON):
+		if err := json.NewDecoder(c.request.Object().Body).Decode(i); err != nil {
+			return NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return nil
+
+	case strings.HasPrefix(ct, ApplicationXML):
+		if err := xml.NewDecoder(c.request.Object().Body).Decode(i); err != nil {
+			return NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return nil
+
+	case strings.HasPrefix(ct, ApplicationForm):
+		return c.bindForm(i)
+
+	case strings.HasPrefix(ct, ApplicationMultipartForm):
+		return c.bindMultipartForm(i)
+
+	default:
+		ok, err := bindRegistered(baseMIME(ct), c.request.Object().Body, i)
+		if ok {
+			return err
+		}
+		return ErrUnsupportedMediaType
+	}
+}
+
+// baseMIME strips any ";param=..." suffix from a Content-Type value (e.g.
+// "application/x-protobuf; charset=utf-8" -> "application/x-protobuf") so
+// registry lookups key on the bare MIME type.
+func baseMIME(ct string) string {
+	if i := strings.Index(ct, ";"); i >= 0 {
+		return strings.TrimSpace(ct[:i])
+	}
+	return ct
+}
+
+func (c *context) bindForm(i interface{}) error {
+	if err := c.request.Object().ParseForm(); err != nil {
+		return NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return bindFormValues(c.request.Object().Form, i)
+}
+
+func (c *context) bindMultipartForm(i interface{}) error {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return err
+	}
+	if err := bindFormValues(url.Values(form.Value), i); err != nil {
+		return err
+	}
+	return bindMultipartFormFiles(form, i)
+}
+
+func (c *context) Render(code int, name string, data interface{}) error {
+	if c.echo == nil || c.echo.renderer == nil {
+		return ErrInternalServerError
+	}
+	buf := new(bytes.Buffer)
+	if err := c.echo.renderer.Render(buf, name, data, c); err != nil {
+		return err
+	}
+	c.response.Header().Set(ContentType, TextHTMLCharsetUTF8)
+	c.response.WriteHeader(code)
+	_, err := c.response.Write(buf.Bytes())
+	return err
+}
+
+func (c *context) JSON(code int, i interface{}) error {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	return c.jsonBlob(code, b)
+}
+
+func (c *context) jsonBlob(code int, b []byte) error {
+	c.response.Header().Set(ContentType, ApplicationJSONCharsetUTF8)
+	c.response.WriteHeader(code)
+	_, err := c.response.Write(b)
+	return err
+}
+
+func (c *context) JSONP(code int, callback string, i interface{}) error {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	c.response.Header().Set(ContentType, ApplicationJavaScriptCharsetUTF8)
+	c.response.WriteHeader(code)
+	if _, err := c.response.Write([]byte(callback + "(")); err != nil {
+		return err
+	}
+	if _, err := c.response.Write(b); err != nil {
+		return err
+	}
+	_, err = c.response.Write([]byte(");"))
+	return err
+}
+
+func (c *context) XML(code int, i interface{}) error {
+	b, err := xml.Marshal(i)
+	if err != nil {
+		return err
+	}
+	c.response.Header().Set(ContentType, ApplicationXMLCharsetUTF8)
+	c.response.WriteHeader(code)
+	if _, err := c.response.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	_, err = c.response.Write(b)
+	return err
+}
+
+func (c *context) String(code int, s string) error {
+	c.response.Header().Set(ContentType, TextPlainCharsetUTF8)
+	c.response.WriteHeader(code)
+	_, err := c.response.Write([]byte(s))
+	return err
+}
+
+func (c *context) HTML(code int, html string) error {
+	c.response.Header().Set(ContentType, TextHTMLCharsetUTF8)
+	c.response.WriteHeader(code)
+	_, err := c.response.Write([]byte(html))
+	return err
+}
+
+func (c *context) Blob(code int, contentType string, b []byte) error {
+	c.response.Header().Set(ContentType, contentType)
+	c.response.WriteHeader(code)
+	_, err := c.response.Write(b)
+	return err
+}
+
+func (c *context) Attachment(r io.Reader, name string) error {
+	c.response.Header().Set(ContentDisposition, "attachment; filename="+name)
+	c.response.WriteHeader(http.StatusOK)
+	_, err := io.Copy(c.response, r)
+	return err
+}
+
+func (c *context) NoContent(code int) error {
+	c.response.WriteHeader(code)
+	return nil
+}
+
+func (c *context) Redirect(code int, u string) error {
+	if code < http.StatusMultipleChoices || code > http.StatusTemporaryRedirect {
+		return NewHTTPError(http.StatusInternalServerError, "invalid redirect status code")
+	}
+	c.response.Header().Set(Location, u)
+	c.response.WriteHeader(code)
+	return nil
+}
+
+func (c *context) Error(err error) {
+	code := http.StatusInternalServerError
+	msg := err.Error()
+	if he, ok := err.(*HTTPError); ok {
+		code = he.Code
+		msg = he.Message
+	}
+	if !c.response.Committed() {
+		c.response.WriteHeader(code)
+		fmt.Fprint(c.response, msg)
+	}
+}
+
+func (c *context) ServeContent(content io.ReadSeeker, name string, modtime time.Time) error {
+	req := c.request.Object()
+	res := c.response
+
+	if t, err := time.Parse(http.TimeFormat, req.Header.Get(IfModifiedSince)); err == nil && !modtime.After(t.Add(1*time.Second)) {
+		res.Header().Del(ContentType)
+		res.Header().Del("Content-Length")
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	res.Header().Set(LastModified, modtime.UTC().Format(http.TimeFormat))
+	http.ServeContent(res, req, name, modtime, content)
+	return nil
+}
+
+// bindFormValues populates the `form:"..."` tagged string fields of i from
+// values. Only string-typed fields are supported; file fields are handled
+// separately by bindMultipartFormFiles.
+func bindFormValues(values url.Values, i interface{}) error {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return NewHTTPError(http.StatusBadRequest, "Bind: destination must be a non-nil pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("form")
+		if name == "" || name == "-" {
+			continue
+		}
+		raw := values.Get(name)
+		if raw == "" {
+			continue
+		}
+		if fv := v.Field(i); fv.Kind() == reflect.String {
+			fv.SetString(raw)
+		}
+	}
+	return nil
+}
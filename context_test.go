@@ -1,6 +1,7 @@
 package lessgo
 
 import (
+	xcontext "context"
 	"encoding/xml"
 	"errors"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -232,9 +234,21 @@ func TestContextFormValue(t *testing.T) {
 }
 
 func TestContextNetContext(t *testing.T) {
-	// c := new(context)
-	// c.Context = xcontext.WithValue(nil, "key", "val")
-	// assert.Equal(t, "val", c.Value("key"))
+	rq := test.NewRequest(GET, "/", nil)
+	c := NewContext(rq, nil, New())
+
+	derived := c.WithValue("key", "val")
+	assert.Equal(t, "val", derived.Value("key"))
+
+	// Set/Get and Value interoperate: a value stored via Set is visible
+	// through Value, and vice versa.
+	c.Set("user", "Joe")
+	assert.Equal(t, "Joe", c.Value("user"))
+
+	timeout, cancel := c.WithTimeout(time.Millisecond)
+	defer cancel()
+	<-timeout.Done()
+	assert.Equal(t, xcontext.DeadlineExceeded, timeout.Err())
 }
 
 func TestContextServeContent(t *testing.T) {
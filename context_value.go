@@ -0,0 +1,87 @@
+package lessgo
+
+import (
+	xcontext "context"
+	"time"
+)
+
+// Deadline, Done, Err and Value make *context satisfy the standard
+// context.Context interface, backed by the incoming request's context. This
+// lets handlers and middleware pass c directly to APIs that expect a
+// context.Context, such as database/sql, gRPC clients or errgroup.
+func (c *context) Deadline() (deadline time.Time, ok bool) {
+	return c.netContext().Deadline()
+}
+
+func (c *context) Done() <-chan struct{} {
+	return c.netContext().Done()
+}
+
+func (c *context) Err() error {
+	return c.netContext().Err()
+}
+
+// Value looks up key in the underlying context.Context chain first, so a
+// value installed via WithValue correctly shadows an equally-keyed entry in
+// the Set/Get store, then falls back to the store, so middleware that
+// injects via either mechanism interoperates.
+func (c *context) Value(key interface{}) interface{} {
+	if v := c.netContext().Value(key); v != nil {
+		return v
+	}
+	if k, ok := key.(string); ok {
+		if v, ok := c.store[k]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// netContext returns the context.Context backing this Context, defaulting
+// to the incoming request's context when no derived one has been set via
+// WithValue/WithCancel/WithTimeout/WithDeadline.
+func (c *context) netContext() xcontext.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return c.request.Context()
+}
+
+// WithValue returns a derived Context carrying key/val, sharing this
+// Context's pnames/pvalues/store but wrapping a new context.Context.
+func (c *context) WithValue(key, val interface{}) Context {
+	return c.derive(xcontext.WithValue(c.netContext(), key, val))
+}
+
+// WithCancel returns a derived Context whose Done channel closes when the
+// returned CancelFunc is called or the parent is canceled.
+func (c *context) WithCancel() (Context, xcontext.CancelFunc) {
+	ctx, cancel := xcontext.WithCancel(c.netContext())
+	return c.derive(ctx), cancel
+}
+
+// WithTimeout returns a derived Context that is canceled after d.
+func (c *context) WithTimeout(d time.Duration) (Context, xcontext.CancelFunc) {
+	ctx, cancel := xcontext.WithTimeout(c.netContext(), d)
+	return c.derive(ctx), cancel
+}
+
+// WithDeadline returns a derived Context that is canceled at t.
+func (c *context) WithDeadline(t time.Time) (Context, xcontext.CancelFunc) {
+	ctx, cancel := xcontext.WithDeadline(c.netContext(), t)
+	return c.derive(ctx), cancel
+}
+
+// derive returns a shallow copy of c backed by ctx, sharing the same
+// request, response and pnames/pvalues. The store is copied rather than
+// shared so a derived Context used concurrently (e.g. passed to a goroutine
+// via WithCancel) never races with Set/Get calls on the original.
+func (c *context) derive(ctx xcontext.Context) *context {
+	cp := *c
+	cp.ctx = ctx
+	cp.store = make(map[string]interface{}, len(c.store))
+	for k, v := range c.store {
+		cp.store[k] = v
+	}
+	return &cp
+}
@@ -0,0 +1,30 @@
+package lessgo
+
+import "io"
+
+// Renderer is the interface used by Context.Render to execute HTML
+// templates.
+type Renderer interface {
+	Render(w io.Writer, name string, data interface{}, c Context) error
+}
+
+// Echo is the top-level framework instance.
+type Echo struct {
+	renderer Renderer
+
+	// MaxMultipartMemory caps the amount of a multipart/form-data request
+	// kept in memory by ParseMultipartForm before spilling to temporary
+	// files. Zero means the default of 32 MiB is used; see
+	// (*Echo).maxMultipartMemory in multipart.go.
+	MaxMultipartMemory int64
+}
+
+// New creates a new Echo instance.
+func New() *Echo {
+	return &Echo{}
+}
+
+// SetRenderer registers the Renderer used by Context.Render.
+func (e *Echo) SetRenderer(r Renderer) {
+	e.renderer = r
+}
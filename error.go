@@ -0,0 +1,34 @@
+package lessgo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// HTTPError represents an error with an associated HTTP status code, as
+// returned by Bind, the renderers and the router.
+type HTTPError struct {
+	Code    int
+	Message string
+}
+
+// NewHTTPError creates a new HTTPError with the given status code. An
+// optional message overrides the default http.StatusText(code).
+func NewHTTPError(code int, message ...interface{}) *HTTPError {
+	he := &HTTPError{Code: code, Message: http.StatusText(code)}
+	if len(message) > 0 {
+		he.Message = fmt.Sprint(message[0])
+	}
+	return he
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+// Common HTTP errors.
+var (
+	ErrUnsupportedMediaType = NewHTTPError(http.StatusUnsupportedMediaType)
+	ErrNotFound             = NewHTTPError(http.StatusNotFound)
+	ErrInternalServerError  = NewHTTPError(http.StatusInternalServerError)
+)
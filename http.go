@@ -0,0 +1,43 @@
+package lessgo
+
+// HTTP methods.
+const (
+	GET     = "GET"
+	HEAD    = "HEAD"
+	POST    = "POST"
+	PUT     = "PUT"
+	DELETE  = "DELETE"
+	PATCH   = "PATCH"
+	OPTIONS = "OPTIONS"
+	CONNECT = "CONNECT"
+	TRACE   = "TRACE"
+)
+
+// Header names.
+const (
+	ContentType        = "Content-Type"
+	ContentDisposition = "Content-Disposition"
+	Location           = "Location"
+	IfModifiedSince    = "If-Modified-Since"
+	LastModified       = "Last-Modified"
+	Accept             = "Accept"
+	Connection         = "Connection"
+)
+
+const charsetUTF8 = "charset=utf-8"
+
+// MIME types.
+const (
+	ApplicationJSON                  = "application/json"
+	ApplicationJSONCharsetUTF8       = ApplicationJSON + "; " + charsetUTF8
+	ApplicationJavaScript            = "application/javascript"
+	ApplicationJavaScriptCharsetUTF8 = ApplicationJavaScript + "; " + charsetUTF8
+	ApplicationXML                   = "application/xml"
+	ApplicationXMLCharsetUTF8        = ApplicationXML + "; " + charsetUTF8
+	ApplicationForm                  = "application/x-www-form-urlencoded"
+	ApplicationMultipartForm         = "multipart/form-data"
+	TextPlain                        = "text/plain"
+	TextPlainCharsetUTF8             = TextPlain + "; " + charsetUTF8
+	TextHTML                         = "text/html"
+	TextHTMLCharsetUTF8              = TextHTML + "; " + charsetUTF8
+)
@@ -0,0 +1,49 @@
+package lessgo
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/vmihailenco/msgpack"
+)
+
+// ApplicationMsgPack is the MIME type used by MsgPack and the msgpack
+// BodyBinder/BodyRenderer registered in init.
+const ApplicationMsgPack = "application/msgpack"
+
+type msgpackCodec struct{}
+
+func (msgpackCodec) Bind(r io.Reader, i interface{}) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := msgpack.Unmarshal(b, i); err != nil {
+		return NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}
+
+func (msgpackCodec) Render(w io.Writer, i interface{}) error {
+	b, err := msgpack.Marshal(i)
+	if err != nil {
+		return NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func init() {
+	c := msgpackCodec{}
+	RegisterBinder(ApplicationMsgPack, c)
+	RegisterRenderer(ApplicationMsgPack, c)
+}
+
+// MsgPack sends a MessagePack response with status code.
+func (c *context) MsgPack(code int, v interface{}) error {
+	c.Response().Header().Set(ContentType, ApplicationMsgPack)
+	c.Response().WriteHeader(code)
+	_, err := renderRegistered(ApplicationMsgPack, c.Response(), v)
+	return err
+}
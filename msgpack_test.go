@@ -0,0 +1,44 @@
+package lessgo
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+func TestContextMsgPack(t *testing.T) {
+	rec := test.NewResponseRecorder()
+	c := NewContext(test.NewRequest(GET, "/", nil), rec, New())
+
+	err := c.MsgPack(http.StatusOK, user{"1", "Joe"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ApplicationMsgPack, rec.Header().Get(ContentType))
+
+		var got user
+		assert.NoError(t, msgpack.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, "1", got.ID)
+		assert.Equal(t, "Joe", got.Name)
+	}
+}
+
+func TestContextBindMsgPack(t *testing.T) {
+	b, err := msgpack.Marshal(user{"1", "Joe"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	rq := test.NewRequest(POST, "/", bytes.NewReader(b))
+	rq.Header().Set(ContentType, ApplicationMsgPack)
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	got := new(user)
+	if assert.NoError(t, c.Bind(got)) {
+		assert.Equal(t, "1", got.ID)
+		assert.Equal(t, "Joe", got.Name)
+	}
+}
@@ -0,0 +1,63 @@
+package lessgo
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+)
+
+// defaultMultipartMemory is the amount of request body kept in memory by
+// ParseMultipartForm before spilling to temporary files, used when the
+// engine has not configured a MaxMultipartMemory.
+const defaultMultipartMemory = 32 << 20 // 32 MiB
+
+// MultipartForm parses and returns the multipart form of the request, using
+// the engine's MaxMultipartMemory (default 32 MiB) as the in-memory part
+// size limit.
+func (c *context) MultipartForm() (*multipart.Form, error) {
+	if err := c.Request().ParseMultipartForm(c.echo.maxMultipartMemory()); err != nil {
+		return nil, NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.Request().MultipartForm(), nil
+}
+
+// FormFile returns the first file for the given multipart form key.
+func (c *context) FormFile(name string) (*multipart.FileHeader, error) {
+	if err := c.Request().ParseMultipartForm(c.echo.maxMultipartMemory()); err != nil {
+		return nil, NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	f, fh, err := c.Request().FormFile(name)
+	if err != nil {
+		return nil, NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	f.Close()
+	return fh, nil
+}
+
+// SaveUploadedFile writes the uploaded file fh to dst.
+func (c *context) SaveUploadedFile(fh *multipart.FileHeader, dst string) error {
+	src, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, src)
+	return err
+}
+
+// maxMultipartMemory returns the engine's configured multipart memory
+// limit, defaulting to defaultMultipartMemory when unset.
+func (e *Echo) maxMultipartMemory() int64 {
+	if e.MaxMultipartMemory > 0 {
+		return e.MaxMultipartMemory
+	}
+	return defaultMultipartMemory
+}
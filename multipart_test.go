@@ -0,0 +1,86 @@
+package lessgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+func newMultipartRequest(t *testing.T, fieldName, fileName string, content []byte) (*bytes.Buffer, string) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	fw, err := w.CreateFormFile(fieldName, fileName)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	_, err = fw.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return &buf, w.FormDataContentType()
+}
+
+func TestContextSaveUploadedFile(t *testing.T) {
+	fixture := filepath.Join("_fixture", "images", "walle.png")
+	content, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Skip("fixture not present: " + fixture)
+	}
+
+	body, contentType := newMultipartRequest(t, "avatar", "walle.png", content)
+	rq := test.NewRequest(POST, "/", body)
+	rq.Header().Set(ContentType, contentType)
+
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+	fh, err := c.FormFile("avatar")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	dst := filepath.Join(os.TempDir(), "lessgo-upload-test.png")
+	defer os.Remove(dst)
+
+	if assert.NoError(t, c.SaveUploadedFile(fh, dst)) {
+		saved, err := ioutil.ReadFile(dst)
+		if assert.NoError(t, err) {
+			assert.Equal(t, content, saved)
+		}
+	}
+}
+
+type avatarUpload struct {
+	Name   string                `form:"name"`
+	Avatar *multipart.FileHeader `form:"avatar"`
+}
+
+func TestContextBindMultipartFormFile(t *testing.T) {
+	content := []byte("not actually a png, just bytes for the round trip")
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	assert.NoError(t, w.WriteField("name", "Joe"))
+	fw, err := w.CreateFormFile("avatar", "walle.png")
+	if assert.NoError(t, err) {
+		_, err = fw.Write(content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	rq := test.NewRequest(POST, "/", &buf)
+	rq.Header().Set(ContentType, w.FormDataContentType())
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	dst := new(avatarUpload)
+	if assert.NoError(t, c.Bind(dst)) {
+		assert.Equal(t, "Joe", dst.Name)
+		if assert.NotNil(t, dst.Avatar) {
+			assert.Equal(t, "walle.png", dst.Avatar.Filename)
+		}
+	}
+}
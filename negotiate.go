@@ -0,0 +1,170 @@
+package lessgo
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NegotiateConfig describes the payloads available for content negotiation,
+// keyed by the MIME types offered in Offered.
+type NegotiateConfig struct {
+	Offered []string
+
+	JSONData interface{}
+	XMLData  interface{}
+
+	HTMLName string
+	HTMLData interface{}
+
+	Data []byte
+}
+
+type acceptSpec struct {
+	typ, subtype string
+	q            float64
+}
+
+// Negotiate inspects the request's Accept header, picks the best match
+// among offered.Offered and dispatches to the corresponding renderer
+// (JSON, XML, HTML or Blob). It returns a *HTTPError with
+// http.StatusNotAcceptable when nothing matches.
+func (c *context) Negotiate(code int, offered NegotiateConfig) error {
+	mime := c.NegotiateFormat(offered.Offered...)
+	if mime == "" {
+		return NewHTTPError(http.StatusNotAcceptable)
+	}
+
+	switch mime {
+	case ApplicationJSON:
+		return c.JSON(code, offered.JSONData)
+	case ApplicationXML:
+		return c.XML(code, offered.XMLData)
+	case "text/html":
+		return c.Render(code, offered.HTMLName, offered.HTMLData)
+	default:
+		return c.Blob(code, mime, offered.Data)
+	}
+}
+
+// NegotiateFormat parses the request's Accept header, applying q-value
+// quality and specificity ordering (type/subtype > type/* > */*), and
+// returns the best match among offered. Per RFC 7231 §5.3.1, an offer whose
+// most specific matching Accept entry has q=0 is explicitly not acceptable,
+// even if a less specific wildcard entry would otherwise match it.
+// NegotiateFormat returns "" when none of offered is acceptable.
+func (c *context) NegotiateFormat(offered ...string) string {
+	specs := parseAccept(c.Request().Header().Get(Accept))
+	if len(specs) == 0 {
+		if len(offered) > 0 {
+			return offered[0]
+		}
+		return ""
+	}
+
+	best := ""
+	bestQ := -1.0
+	bestSpecificity := -1
+	for _, o := range offered {
+		spec, ok := bestAcceptMatch(specs, o)
+		if !ok || spec.q == 0 {
+			continue
+		}
+		specificity := acceptSpecificity(spec)
+		if spec.q > bestQ || (spec.q == bestQ && specificity > bestSpecificity) {
+			best, bestQ, bestSpecificity = o, spec.q, specificity
+		}
+	}
+	return best
+}
+
+// bestAcceptMatch returns the most specific spec in specs that matches
+// offer (type/subtype beats type/* beats */*), since that's the entry whose
+// q-value governs the offer's acceptability.
+func bestAcceptMatch(specs []acceptSpec, offer string) (acceptSpec, bool) {
+	var best acceptSpec
+	found := false
+	for _, s := range specs {
+		if !acceptMatches(s, offer) {
+			continue
+		}
+		if !found || acceptSpecificity(s) > acceptSpecificity(best) {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+func parseAccept(header string) []acceptSpec {
+	if header == "" {
+		return nil
+	}
+
+	var specs []acceptSpec
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		fields := strings.Split(part, ";")
+		mediaType := strings.TrimSpace(fields[0])
+		typ, subtype := splitMediaType(mediaType)
+		if typ == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			if v, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+				q = v
+			}
+		}
+
+		specs = append(specs, acceptSpec{typ: typ, subtype: subtype, q: q})
+	}
+
+	sort.SliceStable(specs, func(i, j int) bool {
+		if specs[i].q != specs[j].q {
+			return specs[i].q > specs[j].q
+		}
+		return acceptSpecificity(specs[i]) > acceptSpecificity(specs[j])
+	})
+	return specs
+}
+
+func splitMediaType(mediaType string) (typ, subtype string) {
+	parts := strings.SplitN(mediaType, "/", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+// acceptSpecificity ranks type/subtype above type/* above */*.
+func acceptSpecificity(s acceptSpec) int {
+	switch {
+	case s.typ != "*" && s.subtype != "*":
+		return 2
+	case s.typ != "*":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func acceptMatches(spec acceptSpec, offer string) bool {
+	typ, subtype := splitMediaType(offer)
+	if spec.typ != "*" && spec.typ != typ {
+		return false
+	}
+	if spec.subtype != "*" && spec.subtype != subtype {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package lessgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+func TestContextNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		accept  string
+		offered []string
+		want    string
+	}{
+		{
+			name:    "quality ordering",
+			accept:  "text/html;q=0.9, application/json;q=1.0",
+			offered: []string{"text/html", "application/json"},
+			want:    "application/json",
+		},
+		{
+			name:    "specificity tie-break",
+			accept:  "*/*, application/json",
+			offered: []string{"text/html", "application/json"},
+			want:    "application/json",
+		},
+		{
+			name:    "wildcard fallback",
+			accept:  "*/*",
+			offered: []string{"text/html", "application/json"},
+			want:    "text/html",
+		},
+		{
+			name:    "no accept header accepts first offer",
+			accept:  "",
+			offered: []string{"application/json"},
+			want:    "application/json",
+		},
+		{
+			name:    "malformed entries are skipped",
+			accept:  "garbage, application/json;q=1.0",
+			offered: []string{"application/json"},
+			want:    "application/json",
+		},
+		{
+			name:    "nothing matches",
+			accept:  "application/xml",
+			offered: []string{"application/json"},
+			want:    "",
+		},
+		{
+			name:    "q=0 explicitly excludes the offer",
+			accept:  "text/html;q=0",
+			offered: []string{"text/html"},
+			want:    "",
+		},
+		{
+			name:    "q=0 on the specific type is not rescued by a wildcard",
+			accept:  "text/html;q=0, */*;q=0.5",
+			offered: []string{"text/html"},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq := test.NewRequest(GET, "/", nil)
+			if tt.accept != "" {
+				rq.Header().Set(Accept, tt.accept)
+			}
+			c := NewContext(rq, nil, New())
+			assert.Equal(t, tt.want, c.NegotiateFormat(tt.offered...))
+		})
+	}
+}
+
+func TestContextNegotiate(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rq.Header().Set(Accept, "application/json")
+	rec := test.NewResponseRecorder()
+	c := NewContext(rq, rec, New())
+
+	err := c.Negotiate(200, NegotiateConfig{
+		Offered:  []string{"application/json", "application/xml"},
+		JSONData: user{"1", "Joe"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestContextNegotiateNotAcceptable(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rq.Header().Set(Accept, "application/pdf")
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	err := c.Negotiate(200, NegotiateConfig{Offered: []string{"application/json"}})
+	if assert.IsType(t, new(HTTPError), err) {
+		assert.Equal(t, 406, err.(*HTTPError).Code)
+	}
+}
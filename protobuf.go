@@ -0,0 +1,90 @@
+package lessgo
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ApplicationProtobuf is the MIME type used by Protobuf and the protobuf
+// BodyBinder/BodyRenderer registered in init.
+const ApplicationProtobuf = "application/x-protobuf"
+
+type protobufCodec struct{}
+
+func (protobufCodec) Bind(r io.Reader, i interface{}) error {
+	msg, ok := i.(proto.Message)
+	if !ok {
+		return NewHTTPError(http.StatusBadRequest, "protobuf: type does not implement proto.Message")
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := proto.Unmarshal(b, msg); err != nil {
+		return NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}
+
+func (protobufCodec) Render(w io.Writer, i interface{}) error {
+	msg, ok := i.(proto.Message)
+	if !ok {
+		return NewHTTPError(http.StatusInternalServerError, "protobuf: type does not implement proto.Message")
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func init() {
+	c := protobufCodec{}
+	RegisterBinder(ApplicationProtobuf, c)
+	RegisterRenderer(ApplicationProtobuf, c)
+}
+
+// Protobuf sends a protobuf response with status code.
+func (c *context) Protobuf(code int, v interface{}) error {
+	c.Response().Header().Set(ContentType, ApplicationProtobuf)
+	c.Response().WriteHeader(code)
+	_, err := renderRegistered(ApplicationProtobuf, c.Response(), v)
+	return err
+}
+
+// ProtobufStream writes a sequence of protobuf messages produced by next to
+// the response, length-delimiting each one and flushing after every
+// message. Unlike Protobuf, it never holds the full stream in memory: only
+// the message currently being written exists at any point, so large or
+// unbounded streams (e.g. a DB cursor fed through next) don't need to be
+// buffered up front. next returns ok == false to end the stream.
+func (c *context) ProtobufStream(code int, next func() (msg proto.Message, ok bool)) error {
+	c.Response().Header().Set(ContentType, ApplicationProtobuf)
+	c.Response().WriteHeader(code)
+
+	var lenBuf [binary.MaxVarintLen64]byte
+	for {
+		msg, ok := next()
+		if !ok {
+			return nil
+		}
+
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		n := binary.PutUvarint(lenBuf[:], uint64(len(b)))
+		if _, err := c.Response().Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := c.Response().Write(b); err != nil {
+			return err
+		}
+		c.Response().Flush()
+	}
+}
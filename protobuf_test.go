@@ -0,0 +1,116 @@
+package lessgo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+// protoUser is a hand-written stand-in for a protoc-generated message, used
+// only to exercise the Protobuf codec without depending on generated code.
+type protoUser struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3"`
+}
+
+func (m *protoUser) Reset()         { *m = protoUser{} }
+func (m *protoUser) String() string { return proto.CompactTextString(m) }
+func (*protoUser) ProtoMessage()    {}
+
+func TestContextProtobuf(t *testing.T) {
+	rec := test.NewResponseRecorder()
+	c := NewContext(test.NewRequest(GET, "/", nil), rec, New())
+
+	err := c.Protobuf(http.StatusOK, &protoUser{Id: "1", Name: "Joe"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ApplicationProtobuf, rec.Header().Get(ContentType))
+		assert.NotEmpty(t, rec.Body.Bytes())
+	}
+}
+
+func TestContextProtobufUnsupportedType(t *testing.T) {
+	e := New()
+	rq := test.NewRequest(POST, "/", nil)
+	rec := test.NewResponseRecorder()
+	c := NewContext(rq, rec, e)
+
+	err := c.Protobuf(http.StatusOK, "not-a-proto-message")
+	assert.Error(t, err)
+}
+
+func TestContextBindProtobuf(t *testing.T) {
+	want := &protoUser{Id: "1", Name: "Joe"}
+	b, err := proto.Marshal(want)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	rq := test.NewRequest(POST, "/", bytes.NewReader(b))
+	rq.Header().Set(ContentType, ApplicationProtobuf)
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	got := new(protoUser)
+	if assert.NoError(t, c.Bind(got)) {
+		assert.Equal(t, want.Id, got.Id)
+		assert.Equal(t, want.Name, got.Name)
+	}
+}
+
+func TestContextProtobufStream(t *testing.T) {
+	rec := test.NewResponseRecorder()
+	c := NewContext(test.NewRequest(GET, "/", nil), rec, New())
+
+	want := []*protoUser{
+		{Id: "1", Name: "Joe"},
+		{Id: "2", Name: "Anna"},
+		{Id: "3", Name: "Mia"},
+	}
+	i := 0
+	err := c.ProtobufStream(http.StatusOK, func() (proto.Message, bool) {
+		if i >= len(want) {
+			return nil, false
+		}
+		msg := want[i]
+		i++
+		return msg, true
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, ApplicationProtobuf, rec.Header().Get(ContentType))
+
+	r := bytes.NewReader(rec.Body.Bytes())
+	for _, w := range want {
+		n, err := binary.ReadUvarint(r)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		b := make([]byte, n)
+		_, err = io.ReadFull(r, b)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		got := new(protoUser)
+		if assert.NoError(t, proto.Unmarshal(b, got)) {
+			assert.Equal(t, w.Id, got.Id)
+			assert.Equal(t, w.Name, got.Name)
+		}
+	}
+	assert.Equal(t, 0, r.Len(), "no trailing bytes after the last frame")
+}
+
+func TestContextBindProtobufUnregisteredMediaType(t *testing.T) {
+	rq := test.NewRequest(POST, "/", bytes.NewReader(nil))
+	rq.Header().Set(ContentType, "application/does-not-exist")
+	c := NewContext(rq, test.NewResponseRecorder(), New())
+
+	err := c.Bind(new(protoUser))
+	assert.Equal(t, ErrUnsupportedMediaType, err)
+}
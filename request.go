@@ -0,0 +1,71 @@
+package lessgo
+
+import (
+	xcontext "context"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// Request wraps the incoming *http.Request.
+type Request struct {
+	request *http.Request
+}
+
+// NewRequest wraps r as a Request.
+func NewRequest(r *http.Request) *Request {
+	return &Request{request: r}
+}
+
+// Header returns the request header.
+func (r *Request) Header() http.Header {
+	return r.request.Header
+}
+
+// Method returns the request method.
+func (r *Request) Method() string {
+	return r.request.Method
+}
+
+// URL returns the request URL.
+func (r *Request) URL() *url.URL {
+	return r.request.URL
+}
+
+// FormValue returns the first value for the named component of the query or
+// form body.
+func (r *Request) FormValue(name string) string {
+	return r.request.FormValue(name)
+}
+
+// QueryParam returns the first value for the named query parameter.
+func (r *Request) QueryParam(name string) string {
+	return r.request.URL.Query().Get(name)
+}
+
+// Context returns the request's context.Context.
+func (r *Request) Context() xcontext.Context {
+	return r.request.Context()
+}
+
+// ParseMultipartForm parses the request body as multipart/form-data, up to
+// maxMemory bytes kept in memory.
+func (r *Request) ParseMultipartForm(maxMemory int64) error {
+	return r.request.ParseMultipartForm(maxMemory)
+}
+
+// FormFile returns the first file for the provided form key.
+func (r *Request) FormFile(name string) (multipart.File, *multipart.FileHeader, error) {
+	return r.request.FormFile(name)
+}
+
+// MultipartForm returns the parsed multipart form, which must have already
+// been populated via ParseMultipartForm.
+func (r *Request) MultipartForm() *multipart.Form {
+	return r.request.MultipartForm
+}
+
+// Object returns the underlying *http.Request.
+func (r *Request) Object() *http.Request {
+	return r.request
+}
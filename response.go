@@ -0,0 +1,64 @@
+package lessgo
+
+import "net/http"
+
+// Response wraps the underlying http.ResponseWriter, tracking whether the
+// status code has been written yet.
+type Response struct {
+	http.ResponseWriter
+	status    int
+	size      int64
+	committed bool
+}
+
+// NewResponse wraps w as a Response.
+func NewResponse(w http.ResponseWriter) *Response {
+	return &Response{ResponseWriter: w, status: http.StatusOK}
+}
+
+// WriteHeader sends an HTTP response header with the given status code. It
+// is a no-op if the header has already been written, matching
+// http.ResponseWriter's documented behavior while letting callers observe
+// the committed status via Status().
+func (r *Response) WriteHeader(code int) {
+	if r.committed {
+		return
+	}
+	r.status = code
+	r.committed = true
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write writes b to the underlying connection, implicitly committing the
+// response with a 200 status if WriteHeader has not been called yet.
+func (r *Response) Write(b []byte) (int, error) {
+	if !r.committed {
+		r.WriteHeader(http.StatusOK)
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.size += int64(n)
+	return n, err
+}
+
+// Status returns the status code written to the response.
+func (r *Response) Status() int {
+	return r.status
+}
+
+// Size returns the number of bytes written to the response body.
+func (r *Response) Size() int64 {
+	return r.size
+}
+
+// Committed reports whether the response header has been written.
+func (r *Response) Committed() bool {
+	return r.committed
+}
+
+// Flush flushes any buffered data to the client, if the underlying
+// http.ResponseWriter supports it.
+func (r *Response) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,105 @@
+package lessgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// SSEOptions carries the optional `id:` and `retry:` fields of an SSE frame.
+type SSEOptions struct {
+	// EventID sets the frame's `id:` line. Empty means omitted.
+	EventID string
+	// Retry sets the frame's `retry:` line in milliseconds. Zero means
+	// omitted.
+	Retry int
+}
+
+// SSEvent writes a single Server-Sent Event frame named name carrying data.
+// data is encoded as JSON unless it is already a string or []byte, in which
+// case it is written verbatim. The response is flushed after the frame so
+// the client receives it immediately.
+func (c *context) SSEvent(name string, data interface{}) error {
+	return c.sseWrite(name, data, nil)
+}
+
+// SSEventWithOptions is like SSEvent but also emits the `id:`/`retry:` lines
+// described by opts.
+func (c *context) SSEventWithOptions(name string, data interface{}, opts *SSEOptions) error {
+	return c.sseWrite(name, data, opts)
+}
+
+func (c *context) sseWrite(name string, data interface{}, opts *SSEOptions) error {
+	c.prepareSSE()
+
+	var buf bytes.Buffer
+	if opts != nil {
+		if opts.EventID != "" {
+			fmt.Fprintf(&buf, "id: %s\n", opts.EventID)
+		}
+		if opts.Retry > 0 {
+			fmt.Fprintf(&buf, "retry: %d\n", opts.Retry)
+		}
+	}
+	if name != "" {
+		fmt.Fprintf(&buf, "event: %s\n", name)
+	}
+
+	payload, err := sseEncode(data)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&buf, "data: %s\n\n", payload)
+
+	if _, err := c.Response().Write(buf.Bytes()); err != nil {
+		return err
+	}
+	c.Response().Flush()
+	return nil
+}
+
+func sseEncode(data interface{}) (string, error) {
+	switch v := data.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+func (c *context) prepareSSE() {
+	h := c.Response().Header()
+	h.Set(ContentType, "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set(Connection, "keep-alive")
+}
+
+// Stream repeatedly calls step, writing to the underlying ResponseWriter,
+// until step returns false or the request's context is done (client
+// disconnected). It is the building block SSEvent is implemented on top of,
+// and can also be used to stream any other keep-alive format.
+func (c *context) Stream(step func(w io.Writer) bool) error {
+	c.prepareSSE()
+	w := c.Response()
+
+	done := c.Request().Context().Done()
+	for {
+		select {
+		case <-done:
+			return c.Request().Context().Err()
+		default:
+		}
+
+		if !step(w) {
+			return nil
+		}
+		w.Flush()
+	}
+}
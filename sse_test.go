@@ -0,0 +1,54 @@
+package lessgo
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lessgo/lessgo/test"
+)
+
+func TestContextSSEvent(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rec := test.NewResponseRecorder()
+	c := NewContext(rq, rec, New())
+
+	err := c.SSEvent("tick", map[string]int{"n": 1})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "text/event-stream", rec.Header().Get(ContentType))
+		assert.Equal(t, "event: tick\ndata: {\"n\":1}\n\n", rec.Body.String())
+	}
+}
+
+func TestContextSSEventWithOptions(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rec := test.NewResponseRecorder()
+	c := NewContext(rq, rec, New())
+
+	err := c.SSEventWithOptions("tick", "hi", &SSEOptions{EventID: "42", Retry: 3000})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "id: 42\nretry: 3000\nevent: tick\ndata: hi\n\n", rec.Body.String())
+	}
+}
+
+func TestContextStream(t *testing.T) {
+	rq := test.NewRequest(GET, "/", nil)
+	rec := test.NewResponseRecorder()
+	c := NewContext(rq, rec, New())
+
+	lines := []string{"a", "b", "c"}
+	i := 0
+	err := c.Stream(func(w io.Writer) bool {
+		if i >= len(lines) {
+			return false
+		}
+		io.WriteString(w, lines[i]+"\n")
+		i++
+		return true
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, strings.Join(lines, "\n")+"\n", rec.Body.String())
+	}
+}